@@ -4,24 +4,71 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
 	"status-cli/configs"
 	"status-cli/services/status"
 
 	"github.com/spf13/cobra"
 )
 
-var debug bool // debug flag
+var (
+	debug              bool          // debug flag
+	allConcurrency     int           // --concurrency flag
+	allDeadline        time.Duration // --deadline flag
+	allOverallDeadline time.Duration // --overall-deadline flag
+	allSlowestCount    int           // --slowest flag
+)
 
 // allCmd represents the "all" status command
 var allCmd = &cobra.Command{
 	Use:   "all",
 	Short: "Show status of all services",
-	Long:  `Show the current status for all configured services, optionally with debug logging.`,
+	Long: `Show the current status for all configured services, fetched
+concurrently with a bounded worker pool. Press Ctrl-C to abort
+in-flight requests. A summary of counts and the slowest services is
+printed at the end, covering every result received before the run
+finished or was aborted.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		// Iterate over all services and show their status
-		for name, url := range configs.Services {
-			// Pass the debug flag into the requests.Options
-			status.PrintDescriptiveStatus(name, url, debug)
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		if allOverallDeadline > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, allOverallDeadline)
+			defer cancel()
+		}
+
+		results := status.FetchAll(ctx, configs.Services, status.FetchOptions{
+			Concurrency:        allConcurrency,
+			PerServiceDeadline: allDeadline,
+			Debug:              debug,
+		})
+
+		collected := make([]status.Result, 0, len(configs.Services))
+		for result := range results {
+			collected = append(collected, result)
+
+			timestamp := time.Now().Format(time.RFC3339)
+			if result.Err != nil {
+				log.Printf("[%s] \033[31mError:\033[0m %s: %v\n", timestamp, result.Service, result.Err)
+				continue
+			}
+			fmt.Printf("[%s] \033[33m%s\033[0m : %s\n", timestamp, result.Service, result.Response.Status.Description)
+		}
+
+		summary := status.Summarize(collected, allSlowestCount)
+		fmt.Printf("\n%d services, %d ok, %d failed\n", summary.Total, summary.Succeeded, summary.Failed)
+		if len(summary.Slowest) > 0 {
+			fmt.Println("Slowest:")
+			for _, r := range summary.Slowest {
+				fmt.Printf("  %-24s %v\n", r.Service, r.Duration)
+			}
 		}
 	},
 }
@@ -31,4 +78,8 @@ func init() {
 
 	// Add a local flag --debug to enable verbose debug logging
 	allCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Enable debug logging for HTTP requests")
+	allCmd.Flags().IntVar(&allConcurrency, "concurrency", 5, "Maximum number of services fetched at once")
+	allCmd.Flags().DurationVar(&allDeadline, "deadline", 10*time.Second, "Per-service fetch deadline (including retries)")
+	allCmd.Flags().DurationVar(&allOverallDeadline, "overall-deadline", 0, "Deadline for the whole run across all services (0 = no limit)")
+	allCmd.Flags().IntVar(&allSlowestCount, "slowest", 5, "Number of slowest services to show in the summary")
 }
@@ -0,0 +1,30 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"status-cli/configs"
+	"status-cli/services/status"
+
+	"github.com/spf13/cobra"
+)
+
+// componentsCmd represents the "components" status command
+var componentsCmd = &cobra.Command{
+	Use:   "components",
+	Short: "Show component status for all services",
+	Long:  `Show the status of every component for all configured services, optionally with debug logging.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		for name, url := range configs.Services {
+			status.PrintComponentsTable(name, url, debug)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(componentsCmd)
+
+	// Add a local flag --debug to enable verbose debug logging
+	componentsCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Enable debug logging for HTTP requests")
+}
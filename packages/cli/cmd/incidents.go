@@ -0,0 +1,30 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"status-cli/configs"
+	"status-cli/services/status"
+
+	"github.com/spf13/cobra"
+)
+
+// incidentsCmd represents the "incidents" status command
+var incidentsCmd = &cobra.Command{
+	Use:   "incidents",
+	Short: "Show unresolved incidents for all services",
+	Long:  `Show unresolved incidents for all configured services, optionally with debug logging.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		for name, url := range configs.Services {
+			status.PrintIncidentsTable(name, url, debug)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(incidentsCmd)
+
+	// Add a local flag --debug to enable verbose debug logging
+	incidentsCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Enable debug logging for HTTP requests")
+}
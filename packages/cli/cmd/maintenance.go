@@ -0,0 +1,30 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"status-cli/configs"
+	"status-cli/services/status"
+
+	"github.com/spf13/cobra"
+)
+
+// maintenanceCmd represents the "maintenance" status command
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Show upcoming scheduled maintenances for all services",
+	Long:  `Show upcoming scheduled maintenance windows for all configured services, optionally with debug logging.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		for name, url := range configs.Services {
+			status.PrintMaintenanceTable(name, url, debug)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(maintenanceCmd)
+
+	// Add a local flag --debug to enable verbose debug logging
+	maintenanceCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Enable debug logging for HTTP requests")
+}
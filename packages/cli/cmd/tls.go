@@ -0,0 +1,65 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"status-cli/utils/requests"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	caCertPath         string
+	insecureSkipVerify bool
+)
+
+// configureTLS builds a requests.ClientOptions from the global --ca-cert
+// and --insecure-skip-verify flags and applies it to the shared HTTP
+// client, so commands behind a corporate MITM proxy (or any non-public
+// CA) don't need to disable verification entirely just to run status-cli.
+func configureTLS(cmd *cobra.Command, args []string) error {
+	if caCertPath == "" && !insecureSkipVerify {
+		return nil
+	}
+
+	opts := requests.ClientOptions{InsecureSkipVerify: insecureSkipVerify}
+
+	if caCertPath != "" {
+		pem, err := os.ReadFile(caCertPath)
+		if err != nil {
+			return fmt.Errorf("read --ca-cert %q: %w", caCertPath, err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("--ca-cert %q: no certificates found", caCertPath)
+		}
+		opts.RootCAs = pool
+	}
+
+	requests.Configure(opts)
+	return nil
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&caCertPath, "ca-cert", "", "Path to a PEM-encoded CA certificate to trust in addition to the system pool")
+	rootCmd.PersistentFlags().BoolVar(&insecureSkipVerify, "insecure-skip-verify", false, "Disable TLS certificate verification (not recommended)")
+
+	existingPreRunE := rootCmd.PersistentPreRunE
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if existingPreRunE != nil {
+			if err := existingPreRunE(cmd, args); err != nil {
+				return err
+			}
+		}
+		return configureTLS(cmd, args)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"status-cli/configs"
+	"status-cli/services/watch"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchInterval   time.Duration
+	watchWebhookURL string
+	watchSlackURL   string
+	watchTUI        bool
+)
+
+// watchCmd represents the "watch" status command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch all services and notify on status/incident changes",
+	Long: `Poll all configured services at --interval, diff each service's
+status and unresolved incidents against the previous poll, and notify
+on any change. Last-seen state is persisted to ~/.status-cli/state.json
+so restarting the command doesn't re-notify on state it has already seen.
+By default this renders a live-refreshing full-screen table (like top);
+pass --tui=false for one printed line per change instead.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		state, err := watch.LoadState()
+		if err != nil {
+			log.Fatalf("Failed to load watch state: %v", err)
+		}
+
+		var sinks []watch.Sink
+		if !watchTUI {
+			sinks = append(sinks, watch.StdoutSink{})
+		}
+		if watchWebhookURL != "" {
+			sinks = append(sinks, watch.WebhookSink{URL: watchWebhookURL})
+		}
+		if watchSlackURL != "" {
+			sinks = append(sinks, watch.SlackSink{WebhookURL: watchSlackURL})
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		opts := watch.Options{
+			Services: configs.Services,
+			Interval: watchInterval,
+			Sinks:    sinks,
+			Debug:    debug,
+		}
+		if watchTUI {
+			opts.Render = watch.RenderTUI
+		}
+
+		if err := watch.Run(ctx, opts, state, watch.SaveState); err != nil && ctx.Err() == nil {
+			log.Fatalf("Watch stopped: %v", err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", 30*time.Second, "Polling interval")
+	watchCmd.Flags().StringVar(&watchWebhookURL, "webhook", "", "Generic webhook URL to POST notifications to")
+	watchCmd.Flags().StringVar(&watchSlackURL, "slack-webhook", "", "Slack incoming webhook URL to post notifications to")
+	watchCmd.Flags().BoolVar(&watchTUI, "tui", true, "Render a live-refreshing full-screen table instead of one line per change")
+	watchCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Enable debug logging for HTTP requests")
+}
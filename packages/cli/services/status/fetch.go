@@ -0,0 +1,130 @@
+package status
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Result is a single service's fetch outcome, streamed by FetchAll.
+type Result struct {
+	Service  string
+	Response Response
+	Err      error
+	Duration time.Duration
+}
+
+// FetchOptions configures FetchAll's concurrency and per-service
+// deadline.
+type FetchOptions struct {
+	// Concurrency bounds how many services are fetched at once.
+	// Defaults to 5 when <= 0.
+	Concurrency int
+	// PerServiceDeadline bounds a single service's fetch (including
+	// retries). Defaults to requests' own 10s default when 0.
+	PerServiceDeadline time.Duration
+	Debug              bool
+}
+
+// job pairs a service name with its status.json URL for the worker pool.
+type job struct {
+	name string
+	url  string
+}
+
+// FetchAll fans GetStatus out across services with a bounded worker
+// pool, streaming each Result as it arrives rather than waiting for the
+// whole batch. ctx governs cancellation: canceling it (e.g. on Ctrl-C,
+// or an overall deadline the caller applied to ctx) aborts in-flight
+// requests instead of merely skipping ones that haven't started.
+// opts.PerServiceDeadline, when set, additionally bounds each individual
+// job so one hung service can't block a worker past that deadline. The
+// returned channel is closed once every service has been attempted or
+// ctx is done.
+func FetchAll(ctx context.Context, services map[string]string, opts FetchOptions) <-chan Result {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 5
+	}
+
+	jobs := make(chan job)
+	results := make(chan Result)
+
+	var workers sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for j := range jobs {
+				jobCtx := ctx
+				cancel := func() {}
+				if opts.PerServiceDeadline > 0 {
+					jobCtx, cancel = context.WithTimeout(ctx, opts.PerServiceDeadline)
+				}
+
+				start := time.Now()
+				resp, err := GetStatusContext(jobCtx, j.url, opts.Debug)
+				result := Result{Service: j.name, Response: resp, Err: err, Duration: time.Since(start)}
+				cancel()
+
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for name, url := range services {
+			select {
+			case jobs <- job{name: name, url: url}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// FetchSummary aggregates a batch of FetchAll results: totals and the
+// slowest services by duration.
+type FetchSummary struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Slowest   []Result
+}
+
+// Summarize aggregates results into a FetchSummary, keeping at most
+// topN of the slowest results (by Duration) in descending order.
+func Summarize(results []Result, topN int) FetchSummary {
+	summary := FetchSummary{Total: len(results)}
+	for _, r := range results {
+		if r.Err != nil {
+			summary.Failed++
+		} else {
+			summary.Succeeded++
+		}
+	}
+
+	sorted := make([]Result, len(results))
+	copy(sorted, results)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Duration > sorted[j].Duration })
+
+	if topN > len(sorted) {
+		topN = len(sorted)
+	}
+	summary.Slowest = sorted[:topN]
+
+	return summary
+}
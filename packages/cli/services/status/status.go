@@ -1,6 +1,7 @@
 package status
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -32,7 +33,13 @@ type Response struct {
 
 // GetStatus fetches status from a URL
 func GetStatus(url string, debug bool) (Response, error) {
-	responseByte, getError := requests.Get(url, requests.Options{Debug: debug})
+	return GetStatusContext(context.Background(), url, debug)
+}
+
+// GetStatusContext fetches status from a URL, aborting the request if
+// ctx is canceled or its deadline expires.
+func GetStatusContext(ctx context.Context, url string, debug bool) (Response, error) {
+	responseByte, getError := requests.Get(url, requests.Options{Context: ctx, Debug: debug})
 	if getError != nil {
 		return Response{}, getError
 	}
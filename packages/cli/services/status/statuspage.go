@@ -0,0 +1,217 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"status-cli/utils/requests"
+)
+
+// AffectedComponent describes a component touched by an incident update.
+type AffectedComponent struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	OldStatus string `json:"old_status"`
+	NewStatus string `json:"new_status"`
+}
+
+// IncidentUpdate is a single timestamped entry in an incident's timeline.
+type IncidentUpdate struct {
+	Id                 string              `json:"id"`
+	Status             string              `json:"status"`
+	Body               string              `json:"body"`
+	IncidentId         string              `json:"incident_id"`
+	CreatedAt          string              `json:"created_at"`
+	UpdatedAt          string              `json:"updated_at"`
+	DisplayAt          string              `json:"display_at"`
+	AffectedComponents []AffectedComponent `json:"affected_components"`
+}
+
+// Component represents a single Statuspage component, e.g. "API" or "CDN".
+type Component struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	Position    int    `json:"position"`
+	GroupId     string `json:"group_id"`
+	PageId      string `json:"page_id"`
+	Group       bool   `json:"group"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// Incident represents a Statuspage incident and its update timeline.
+type Incident struct {
+	Id              string           `json:"id"`
+	Name            string           `json:"name"`
+	Status          string           `json:"status"`
+	Impact          string           `json:"impact"`
+	Shortlink       string           `json:"shortlink"`
+	PageId          string           `json:"page_id"`
+	CreatedAt       string           `json:"created_at"`
+	UpdatedAt       string           `json:"updated_at"`
+	MonitoringAt    string           `json:"monitoring_at"`
+	ResolvedAt      string           `json:"resolved_at"`
+	IncidentUpdates []IncidentUpdate `json:"incident_updates"`
+}
+
+// ScheduledMaintenance represents a planned maintenance window.
+type ScheduledMaintenance struct {
+	Id              string           `json:"id"`
+	Name            string           `json:"name"`
+	Status          string           `json:"status"`
+	Impact          string           `json:"impact"`
+	Shortlink       string           `json:"shortlink"`
+	PageId          string           `json:"page_id"`
+	ScheduledFor    string           `json:"scheduled_for"`
+	ScheduledUntil  string           `json:"scheduled_until"`
+	CreatedAt       string           `json:"created_at"`
+	UpdatedAt       string           `json:"updated_at"`
+	IncidentUpdates []IncidentUpdate `json:"incident_updates"`
+}
+
+// ComponentsResponse wraps the /components.json response.
+type ComponentsResponse struct {
+	Page       Page        `json:"page"`
+	Components []Component `json:"components"`
+}
+
+// IncidentsResponse wraps the /incidents.json and /incidents/unresolved.json responses.
+type IncidentsResponse struct {
+	Page      Page       `json:"page"`
+	Incidents []Incident `json:"incidents"`
+}
+
+// ScheduledMaintenancesResponse wraps the /scheduled-maintenances*.json responses.
+type ScheduledMaintenancesResponse struct {
+	Page                  Page                   `json:"page"`
+	ScheduledMaintenances []ScheduledMaintenance `json:"scheduled_maintenances"`
+}
+
+// Summary wraps the /summary.json response, the full page status in one call.
+type Summary struct {
+	Page                  Page                   `json:"page"`
+	Status                Status                 `json:"status"`
+	Components            []Component            `json:"components"`
+	Incidents             []Incident             `json:"incidents"`
+	ScheduledMaintenances []ScheduledMaintenance `json:"scheduled_maintenances"`
+}
+
+// pageBaseURL returns the Statuspage v2 API base for a configured
+// status.json URL (e.g. "https://x.status.io/api/v2/"), so sibling
+// endpoints can be built from it.
+func pageBaseURL(statusURL string) string {
+	return strings.TrimSuffix(statusURL, "status.json")
+}
+
+// fetchJSON GETs url and unmarshals the JSON body into out.
+func fetchJSON(url string, debug bool, out interface{}) error {
+	responseByte, getError := requests.Get(url, requests.Options{Debug: debug})
+	if getError != nil {
+		return getError
+	}
+	return json.Unmarshal(responseByte, out)
+}
+
+// GetSummary fetches the full page summary: status, components,
+// incidents, and scheduled maintenances in a single call.
+func GetSummary(statusURL string, debug bool) (Summary, error) {
+	var resp Summary
+	err := fetchJSON(pageBaseURL(statusURL)+"summary.json", debug, &resp)
+	return resp, err
+}
+
+// GetComponents fetches the page's components and their current status.
+func GetComponents(statusURL string, debug bool) (ComponentsResponse, error) {
+	var resp ComponentsResponse
+	err := fetchJSON(pageBaseURL(statusURL)+"components.json", debug, &resp)
+	return resp, err
+}
+
+// GetUnresolvedIncidents fetches incidents that have not yet been resolved.
+func GetUnresolvedIncidents(statusURL string, debug bool) (IncidentsResponse, error) {
+	var resp IncidentsResponse
+	err := fetchJSON(pageBaseURL(statusURL)+"incidents/unresolved.json", debug, &resp)
+	return resp, err
+}
+
+// GetUpcomingMaintenances fetches scheduled maintenance windows that
+// haven't started yet.
+func GetUpcomingMaintenances(statusURL string, debug bool) (ScheduledMaintenancesResponse, error) {
+	var resp ScheduledMaintenancesResponse
+	err := fetchJSON(pageBaseURL(statusURL)+"scheduled-maintenances/upcoming.json", debug, &resp)
+	return resp, err
+}
+
+// logFetchError prints a fetch failure the same way the status printers do.
+func logFetchError(err error) {
+	log.Printf("[%s] \033[31mError:\033[0m %v\n", time.Now().Format(time.RFC3339), err)
+}
+
+// PrintComponentsTable fetches components for name/url and renders them as a table.
+func PrintComponentsTable(name, url string, debug bool) {
+	resp, err := GetComponents(url, debug)
+	if err != nil {
+		logFetchError(err)
+		return
+	}
+
+	fmt.Printf("\033[34m%s\033[0m components\n", name)
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tUPDATED AT")
+	for _, component := range resp.Components {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", component.Name, component.Status, component.UpdatedAt)
+	}
+	w.Flush()
+}
+
+// PrintIncidentsTable fetches unresolved incidents for name/url and renders them as a table.
+func PrintIncidentsTable(name, url string, debug bool) {
+	resp, err := GetUnresolvedIncidents(url, debug)
+	if err != nil {
+		logFetchError(err)
+		return
+	}
+
+	fmt.Printf("\033[34m%s\033[0m incidents\n", name)
+	if len(resp.Incidents) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tIMPACT\tSTATUS\tCREATED AT")
+	for _, incident := range resp.Incidents {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", incident.Name, incident.Impact, incident.Status, incident.CreatedAt)
+	}
+	w.Flush()
+}
+
+// PrintMaintenanceTable fetches upcoming scheduled maintenances for
+// name/url and renders them as a table.
+func PrintMaintenanceTable(name, url string, debug bool) {
+	resp, err := GetUpcomingMaintenances(url, debug)
+	if err != nil {
+		logFetchError(err)
+		return
+	}
+
+	fmt.Printf("\033[34m%s\033[0m scheduled maintenances\n", name)
+	if len(resp.ScheduledMaintenances) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tSCHEDULED FOR\tSCHEDULED UNTIL")
+	for _, maintenance := range resp.ScheduledMaintenances {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", maintenance.Name, maintenance.Status, maintenance.ScheduledFor, maintenance.ScheduledUntil)
+	}
+	w.Flush()
+}
@@ -0,0 +1,73 @@
+package watch
+
+import (
+	"fmt"
+	"time"
+
+	"status-cli/utils/requests"
+)
+
+// StdoutSink prints notifications to the terminal, similar in style to
+// status.PrintDescriptiveStatus.
+type StdoutSink struct{}
+
+// Notify implements Sink.
+func (StdoutSink) Notify(n Notification) error {
+	const (
+		ColorYellow = "\033[33m"
+		ColorReset  = "\033[0m"
+	)
+	fmt.Printf(
+		"[%s] %s%s%s : %s (incidents: %d)\n",
+		n.Time.Format(time.RFC3339),
+		ColorYellow, n.Service, ColorReset,
+		transition(n.Old.Indicator, n.New.Indicator),
+		len(n.New.UnresolvedIncidentIds),
+	)
+	return nil
+}
+
+// webhookPayload is the JSON body posted by WebhookSink.
+type webhookPayload struct {
+	Service string       `json:"service"`
+	Old     ServiceState `json:"old"`
+	New     ServiceState `json:"new"`
+	Time    time.Time    `json:"time"`
+}
+
+// WebhookSink POSTs a JSON payload describing the old/new state to a
+// generic webhook URL, reusing the package's retrying HTTP client.
+type WebhookSink struct {
+	URL string
+}
+
+// Notify implements Sink.
+func (w WebhookSink) Notify(n Notification) error {
+	_, err := requests.Post(w.URL, requests.Options{
+		Body:    webhookPayload{Service: n.Service, Old: n.Old, New: n.New, Time: n.Time},
+		Retries: 3,
+	})
+	return err
+}
+
+// slackMessage is the payload shape expected by Slack incoming webhooks.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// SlackSink posts a human-readable message to a Slack incoming webhook.
+type SlackSink struct {
+	WebhookURL string
+}
+
+// Notify implements Sink.
+func (s SlackSink) Notify(n Notification) error {
+	text := fmt.Sprintf(
+		"*%s*: %s (%d unresolved incident(s))",
+		n.Service,
+		transition(n.Old.Indicator, n.New.Indicator),
+		len(n.New.UnresolvedIncidentIds),
+	)
+	_, err := requests.Post(s.WebhookURL, requests.Options{Body: slackMessage{Text: text}})
+	return err
+}
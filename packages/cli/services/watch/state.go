@@ -0,0 +1,59 @@
+package watch
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// statePath returns ~/.status-cli/state.json, where watch persists the
+// last-seen state of every service so restarts don't re-notify.
+func statePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".status-cli", "state.json"), nil
+}
+
+// LoadState reads the previously persisted service states, returning an
+// empty map (not an error) if no state file exists yet.
+func LoadState() (map[string]ServiceState, error) {
+	path, err := statePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[string]ServiceState{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state map[string]ServiceState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// SaveState persists the current service states to ~/.status-cli/state.json.
+func SaveState(state map[string]ServiceState) error {
+	path, err := statePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,39 @@
+package watch
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// RenderTUI redraws a full-screen table of every service's current
+// state, similar to `top`. Pass it as Options.Render to have Run call it
+// after every poll, not just when something changed, so the view stays
+// live even when nothing is transitioning.
+func RenderTUI(states map[string]ServiceState) {
+	const (
+		ColorBlue  = "\033[34m"
+		ColorReset = "\033[0m"
+	)
+
+	// Move cursor home and clear the screen before redrawing, like top.
+	fmt.Print("\033[H\033[2J")
+
+	fmt.Printf("%sstatus-cli watch%s — %s\n\n", ColorBlue, ColorReset, time.Now().Format(time.RFC3339))
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "SERVICE\tINDICATOR\tDESCRIPTION\tUNRESOLVED INCIDENTS")
+	for _, name := range names {
+		s := states[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", name, s.Indicator, s.Description, len(s.UnresolvedIncidentIds))
+	}
+	w.Flush()
+}
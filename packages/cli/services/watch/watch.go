@@ -0,0 +1,185 @@
+// Package watch polls configured services for status/incident changes
+// and delivers notifications through pluggable sinks.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"status-cli/services/status"
+)
+
+// ServiceState is the last-seen snapshot of a service, used to detect
+// transitions between polls.
+type ServiceState struct {
+	Indicator             string   `json:"indicator"`
+	Description           string   `json:"description"`
+	UnresolvedIncidentIds []string `json:"unresolved_incident_ids"`
+}
+
+// equal reports whether s and other represent the same observed state.
+func (s ServiceState) equal(other ServiceState) bool {
+	if s.Indicator != other.Indicator || s.Description != other.Description {
+		return false
+	}
+	if len(s.UnresolvedIncidentIds) != len(other.UnresolvedIncidentIds) {
+		return false
+	}
+	for i, id := range s.UnresolvedIncidentIds {
+		if other.UnresolvedIncidentIds[i] != id {
+			return false
+		}
+	}
+	return true
+}
+
+// Notification describes a detected change in a service's status or
+// unresolved incidents, delivered to every configured Sink.
+type Notification struct {
+	Service string
+	Old     ServiceState
+	New     ServiceState
+	Time    time.Time
+}
+
+// Sink delivers a Notification somewhere: stdout, a webhook, Slack, etc.
+type Sink interface {
+	Notify(n Notification) error
+}
+
+// Options configures a watch run.
+type Options struct {
+	Services map[string]string
+	Interval time.Duration
+	Sinks    []Sink
+	Debug    bool
+	// Render, if set, is called with the full current snapshot after
+	// every poll — not just when a service's state changed — so it can
+	// drive a live-refreshing view (see RenderTUI) the way `top` redraws
+	// its whole screen on every tick.
+	Render func(map[string]ServiceState)
+}
+
+// fetchState fetches the current status and unresolved incidents for a
+// service and collapses them into a ServiceState.
+func fetchState(url string, debug bool) (ServiceState, error) {
+	resp, err := status.GetStatus(url, debug)
+	if err != nil {
+		return ServiceState{}, err
+	}
+
+	incidents, err := status.GetUnresolvedIncidents(url, debug)
+	if err != nil {
+		return ServiceState{}, err
+	}
+
+	ids := make([]string, 0, len(incidents.Incidents))
+	for _, incident := range incidents.Incidents {
+		ids = append(ids, incident.Id)
+	}
+	sort.Strings(ids)
+
+	return ServiceState{
+		Indicator:             resp.Status.Indicator,
+		Description:           resp.Status.Description,
+		UnresolvedIncidentIds: ids,
+	}, nil
+}
+
+// Poll fetches the current state of every configured service, comparing
+// it against previous (keyed by service name) and returning notifications
+// for services whose state changed. previous may be nil for a first run.
+// The returned state map should be persisted and passed back in as
+// previous on the next call.
+func Poll(services map[string]string, previous map[string]ServiceState, debug bool) (map[string]ServiceState, []Notification) {
+	current := make(map[string]ServiceState, len(services))
+	var notifications []Notification
+
+	for name, url := range services {
+		state, err := fetchState(url, debug)
+		if err != nil {
+			log.Printf("[%s] \033[31mError:\033[0m watch %s: %v\n", time.Now().Format(time.RFC3339), name, err)
+			// Carry the last-known state forward instead of dropping it,
+			// so a transient fetch error doesn't make the next successful
+			// poll look like a first-ever observation and swallow
+			// whatever transition actually happened during the outage.
+			if previousState, ok := previous[name]; ok {
+				current[name] = previousState
+			}
+			continue
+		}
+		current[name] = state
+
+		old, seen := previous[name]
+		if seen && !old.equal(state) {
+			notifications = append(notifications, Notification{
+				Service: name,
+				Old:     old,
+				New:     state,
+				Time:    time.Now(),
+			})
+		}
+	}
+
+	return current, notifications
+}
+
+// Run polls opts.Services every opts.Interval until ctx is done,
+// delivering a Notification to every configured Sink whenever a
+// service's indicator, description, or unresolved-incident set changes.
+// state is the previously persisted snapshot (nil on a fresh start); Run
+// calls persist after every poll so restarts don't re-notify on
+// already-seen state.
+func Run(ctx context.Context, opts Options, state map[string]ServiceState, persist func(map[string]ServiceState) error) error {
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	poll := func() error {
+		next, notifications := Poll(opts.Services, state, opts.Debug)
+		state = next
+
+		if opts.Render != nil {
+			opts.Render(state)
+		}
+
+		for _, n := range notifications {
+			for _, sink := range opts.Sinks {
+				if err := sink.Notify(n); err != nil {
+					log.Printf("[%s] \033[31mError:\033[0m notify sink: %v\n", time.Now().Format(time.RFC3339), err)
+				}
+			}
+		}
+
+		if persist != nil {
+			return persist(state)
+		}
+		return nil
+	}
+
+	if err := poll(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := poll(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// transition renders an indicator change as "old -> updated", or just
+// "updated" when nothing changed, for use by Sink implementations.
+func transition(old, updated string) string {
+	if old == "" || old == updated {
+		return updated
+	}
+	return fmt.Sprintf("%s -> %s", old, updated)
+}
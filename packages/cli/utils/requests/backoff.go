@@ -0,0 +1,146 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures retry backoff timing and which response
+// statuses (beyond 5xx, which is always retried) count as retryable.
+type RetryPolicy struct {
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	Multiplier float64
+	// Jitter enables full jitter on computed backoff delays. A *bool so
+	// "unset" (defaults to on) is distinguishable from an explicit
+	// false; use BoolPtr(false) to opt out.
+	Jitter  *bool
+	RetryOn []int
+}
+
+// defaultRetryPolicy is used for any RetryPolicy field left at its zero
+// value.
+var defaultRetryPolicy = RetryPolicy{
+	BaseDelay:  300 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
+	Multiplier: 2,
+	Jitter:     BoolPtr(true),
+}
+
+// BoolPtr returns a pointer to b, for populating RetryPolicy.Jitter
+// (or any other *bool option) from a literal.
+func BoolPtr(b bool) *bool { return &b }
+
+// resolveRetryPolicy fills in defaultRetryPolicy for any zero-valued
+// field on policy.
+func resolveRetryPolicy(policy RetryPolicy) RetryPolicy {
+	if policy.BaseDelay == 0 {
+		policy.BaseDelay = defaultRetryPolicy.BaseDelay
+	}
+	if policy.MaxDelay == 0 {
+		policy.MaxDelay = defaultRetryPolicy.MaxDelay
+	}
+	if policy.Multiplier == 0 {
+		policy.Multiplier = defaultRetryPolicy.Multiplier
+	}
+	if policy.Jitter == nil {
+		policy.Jitter = defaultRetryPolicy.Jitter
+	}
+	return policy
+}
+
+// shouldRetry determines whether a request should be retried.
+// Retries happen on:
+// - network errors
+// - HTTP 5xx server errors
+// - statuses listed in policy.RetryOn (e.g. 429)
+// - only if attempts < maxRetries
+func shouldRetry(err error, status int, attempt, maxRetries int, policy RetryPolicy) bool {
+	if attempt >= maxRetries {
+		return false
+	}
+
+	// Retry network errors (connection reset, timeout, etc.)
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr)
+	}
+
+	if status >= 500 && status <= 599 {
+		return true
+	}
+	for _, s := range policy.RetryOn {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// computeBackoff returns how long to wait before the next retry attempt.
+// It honors retryAfter (parsed from a Retry-After response header) when
+// positive; otherwise it computes min(MaxDelay, BaseDelay*Multiplier^attempt)
+// and, if policy.Jitter is set, applies full jitter by picking a random
+// duration in [0, delay).
+func computeBackoff(attempt int, policy RetryPolicy, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := time.Duration(float64(policy.BaseDelay) * math.Pow(policy.Multiplier, float64(attempt)))
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if policy.Jitter != nil && *policy.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay)))
+	}
+	return delay
+}
+
+// backoff waits before trying the next retry attempt, returning early if
+// ctx is canceled so a caller's cancellation (e.g. Ctrl-C) aborts the
+// wait itself rather than only taking effect between attempts.
+func backoff(ctx context.Context, attempt int, policy RetryPolicy, retryAfter time.Duration) {
+	delay := computeBackoff(attempt, policy, retryAfter)
+	if delay <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value in either the
+// delay-seconds or HTTP-date form, returning 0 if it can't be parsed or
+// the server asked for a time already in the past.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
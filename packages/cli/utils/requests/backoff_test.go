@@ -0,0 +1,129 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestComputeBackoff(t *testing.T) {
+	noJitter := RetryPolicy{
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   1 * time.Second,
+		Multiplier: 2,
+		Jitter:     BoolPtr(false),
+	}
+
+	tests := []struct {
+		name       string
+		attempt    int
+		policy     RetryPolicy
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{"retryAfter takes priority over computed delay", 0, noJitter, 5 * time.Second, 5 * time.Second},
+		{"attempt 0 is base delay", 0, noJitter, 0, 100 * time.Millisecond},
+		{"attempt 1 applies the multiplier", 1, noJitter, 0, 200 * time.Millisecond},
+		{"attempt 2 applies the multiplier twice", 2, noJitter, 0, 400 * time.Millisecond},
+		{"delay is capped at MaxDelay", 10, noJitter, 0, 1 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeBackoff(tt.attempt, tt.policy, tt.retryAfter)
+			if got != tt.want {
+				t.Errorf("computeBackoff(%d, ..., %v) = %v, want %v", tt.attempt, tt.retryAfter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeBackoffJitterStaysInBounds(t *testing.T) {
+	policy := RetryPolicy{
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   1 * time.Second,
+		Multiplier: 2,
+		Jitter:     BoolPtr(true),
+	}
+
+	for i := 0; i < 50; i++ {
+		got := computeBackoff(3, policy, 0)
+		if got < 0 || got >= 800*time.Millisecond {
+			t.Fatalf("computeBackoff with jitter = %v, want in [0, 800ms)", got)
+		}
+	}
+}
+
+func TestResolveRetryPolicyJitterUnsetDefaultsOn(t *testing.T) {
+	policy := resolveRetryPolicy(RetryPolicy{})
+	if policy.Jitter == nil || !*policy.Jitter {
+		t.Fatalf("resolveRetryPolicy with unset Jitter = %v, want true", policy.Jitter)
+	}
+}
+
+func TestResolveRetryPolicyJitterExplicitFalseIsPreserved(t *testing.T) {
+	policy := resolveRetryPolicy(RetryPolicy{Jitter: BoolPtr(false)})
+	if policy.Jitter == nil || *policy.Jitter {
+		t.Fatalf("resolveRetryPolicy with explicit Jitter=false = %v, want false", policy.Jitter)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{"empty value", "", 0},
+		{"seconds form", "5", 5 * time.Second},
+		{"zero seconds", "0", 0},
+		{"negative seconds", "-5", 0},
+		{"garbage value", "not-a-date", 0},
+		{"HTTP-date in the past", time.Now().Add(-1 * time.Hour).UTC().Format(http.TimeFormat), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseRetryAfter(tt.value)
+			if got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfterHTTPDateInFuture(t *testing.T) {
+	future := time.Now().Add(1 * time.Hour)
+	got := parseRetryAfter(future.UTC().Format(http.TimeFormat))
+	if got <= 0 || got > 1*time.Hour {
+		t.Fatalf("parseRetryAfter(future date) = %v, want roughly 1h", got)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	policy := RetryPolicy{RetryOn: []int{429}}
+
+	tests := []struct {
+		name       string
+		err        error
+		status     int
+		attempt    int
+		maxRetries int
+		want       bool
+	}{
+		{"no retries left", nil, 500, 1, 1, false},
+		{"5xx is retried", nil, 503, 0, 3, true},
+		{"2xx is not retried", nil, 200, 0, 3, false},
+		{"status in RetryOn is retried", nil, 429, 0, 3, true},
+		{"status not in RetryOn is not retried", nil, 400, 0, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shouldRetry(tt.err, tt.status, tt.attempt, tt.maxRetries, policy)
+			if got != tt.want {
+				t.Errorf("shouldRetry(...) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
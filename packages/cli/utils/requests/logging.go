@@ -0,0 +1,184 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"text/template"
+	"time"
+)
+
+// maxLogBodyLength caps how much of a request/response body is rendered
+// in logs.
+const maxLogBodyLength = 1000
+
+// LogData is the data model exposed to RequestLogTemplate and
+// ResponseLogTemplate. Status and DurationMs are zero-valued on the
+// pre-flight request log.
+type LogData struct {
+	Method     string
+	URL        string
+	Headers    http.Header
+	Body       string
+	Status     string
+	DurationMs int64
+	RequestID  string
+}
+
+// Logger receives a fully rendered request or response log line. The
+// default Logger writes to the standard log package; callers can supply
+// their own to route logs to zap/zerolog/etc. without editing this
+// package.
+type Logger interface {
+	Log(line string)
+}
+
+// stdLogger is the default Logger, used when Options.Logger is nil.
+type stdLogger struct{}
+
+func (stdLogger) Log(line string) { log.Println(line) }
+
+var defaultLogger Logger = stdLogger{}
+
+var logTemplateFuncs = template.FuncMap{"join": joinHeaderValues}
+
+// DefaultRequestLogTemplate renders the request ID, method, URL, headers
+// and body in the same colored style as the response log; used when
+// Options.RequestLogTemplate is nil.
+var DefaultRequestLogTemplate = template.Must(template.New("defaultRequestLog").Funcs(logTemplateFuncs).Parse(
+	ColorBlue + "===== HTTP Request Debug =====" + ColorReset + "\n" +
+		"{{if .RequestID}}Request-ID: " + ColorCyan + "{{.RequestID}}" + ColorReset + "\n{{end}}" +
+		"Method: {{.Method}}\n" +
+		"URL: {{.URL}}\n" +
+		"Headers: {{range $k, $v := .Headers}}" + ColorCyan + "{{$k}}" + ColorReset + ": {{join $v}}; {{end}}\n" +
+		"Body: " + ColorGray + "{{.Body}}" + ColorReset,
+))
+
+// DefaultResponseLogTemplate renders the same response debug info this
+// package has always printed; used when Options.ResponseLogTemplate is nil.
+var DefaultResponseLogTemplate = template.Must(template.New("defaultResponseLog").Funcs(logTemplateFuncs).Parse(
+	ColorBlue + "===== HTTP Response Debug =====" + ColorReset + "\n" +
+		"{{if .RequestID}}Request-ID: " + ColorCyan + "{{.RequestID}}" + ColorReset + "\n{{end}}" +
+		"Status: {{.Status}} ({{.DurationMs}}ms)\n" +
+		"Headers: {{range $k, $v := .Headers}}" + ColorCyan + "{{$k}}" + ColorReset + ": {{join $v}}; {{end}}\n" +
+		"Body: " + ColorGray + "{{.Body}}" + ColorReset,
+))
+
+// redactHeaders clones headers, replacing the value of any header whose
+// name is in names (case-insensitive) with "[REDACTED]".
+func redactHeaders(headers http.Header, names []string) http.Header {
+	if len(names) == 0 {
+		return headers
+	}
+	redactSet := make(map[string]bool, len(names))
+	for _, n := range names {
+		redactSet[http.CanonicalHeaderKey(n)] = true
+	}
+
+	cloned := headers.Clone()
+	for k := range cloned {
+		if redactSet[http.CanonicalHeaderKey(k)] {
+			cloned[k] = []string{"[REDACTED]"}
+		}
+	}
+	return cloned
+}
+
+// truncateBody shortens body for logging, matching the previous
+// debug-log truncation behavior.
+func truncateBody(body []byte) string {
+	s := string(body)
+	if len(s) > maxLogBodyLength {
+		return s[:maxLogBodyLength] + "...[truncated]"
+	}
+	return s
+}
+
+// requestID extracts the request ID RequestIDMiddleware (or doRequest,
+// before it runs) attached to req, checking the header first and falling
+// back to the context value.
+func requestID(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+	if id := req.Header.Get(HEADER_X_REQUEST_ID); id != "" {
+		return id
+	}
+	return RequestIDFromContext(req.Context())
+}
+
+// renderLog renders data through tmpl (falling back to defaultTmpl when
+// nil), or as JSON when options.JSONLog is set, and emits it via
+// options.Logger (falling back to the package default logger when nil).
+func renderLog(tmpl *template.Template, defaultTmpl *template.Template, data LogData, options Options) {
+	logger := options.Logger
+	if logger == nil {
+		logger = defaultLogger
+	}
+
+	if options.JSONLog {
+		jsonBytes, err := json.Marshal(data)
+		if err != nil {
+			logger.Log(err.Error())
+			return
+		}
+		logger.Log(string(jsonBytes))
+		return
+	}
+
+	active := tmpl
+	if active == nil {
+		active = defaultTmpl
+	}
+
+	var buf bytes.Buffer
+	if err := active.Execute(&buf, data); err != nil {
+		logger.Log(err.Error())
+		return
+	}
+	logger.Log(buf.String())
+}
+
+// logRequest emits the pre-flight request log, if debug logging is
+// enabled on options.
+func logRequest(req *http.Request, options Options) {
+	if !options.Debug || req == nil {
+		return
+	}
+
+	var bodyBytes []byte
+	if options.Body != nil {
+		bodyBytes, _ = json.Marshal(options.Body)
+	}
+
+	data := LogData{
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Headers:   redactHeaders(req.Header, options.RedactHeaders),
+		Body:      truncateBody(bodyBytes),
+		RequestID: requestID(req),
+	}
+	renderLog(options.RequestLogTemplate, DefaultRequestLogTemplate, data, options)
+}
+
+// logResponseData emits the post-flight response log, if debug logging
+// is enabled on options.
+func logResponseData(req *http.Request, resp *http.Response, body []byte, duration time.Duration, options Options) {
+	if !options.Debug || resp == nil {
+		return
+	}
+
+	data := LogData{
+		Status:     resp.Status,
+		Headers:    redactHeaders(resp.Header, options.RedactHeaders),
+		Body:       truncateBody(body),
+		DurationMs: duration.Milliseconds(),
+		RequestID:  requestID(req),
+	}
+	if req != nil {
+		data.Method = req.Method
+		data.URL = req.URL.String()
+	}
+	renderLog(options.ResponseLogTemplate, DefaultResponseLogTemplate, data, options)
+}
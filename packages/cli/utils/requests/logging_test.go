@@ -0,0 +1,97 @@
+package requests
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeadersIsCaseInsensitive(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret")
+	headers.Set("X-Api-Key", "topsecret")
+	headers.Set("Accept", "application/json")
+
+	redacted := redactHeaders(headers, []string{"authorization", "X-API-KEY"})
+
+	if got := redacted.Get("Authorization"); got != "[REDACTED]" {
+		t.Errorf("Authorization = %q, want [REDACTED]", got)
+	}
+	if got := redacted.Get("X-Api-Key"); got != "[REDACTED]" {
+		t.Errorf("X-Api-Key = %q, want [REDACTED]", got)
+	}
+	if got := redacted.Get("Accept"); got != "application/json" {
+		t.Errorf("Accept = %q, want untouched", got)
+	}
+}
+
+func TestRedactHeadersLeavesOriginalUntouched(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret")
+
+	redactHeaders(headers, []string{"Authorization"})
+
+	if got := headers.Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("original headers were mutated: got %q", got)
+	}
+}
+
+func TestRedactHeadersNoNamesReturnsSameHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Accept", "application/json")
+
+	got := redactHeaders(headers, nil)
+	if got.Get("Accept") != "application/json" {
+		t.Errorf("Accept = %q, want application/json", got.Get("Accept"))
+	}
+}
+
+type captureLogger struct{ lines []string }
+
+func (c *captureLogger) Log(line string) { c.lines = append(c.lines, line) }
+
+func TestRenderLogTemplate(t *testing.T) {
+	logger := &captureLogger{}
+	data := LogData{Method: "GET", URL: "http://example.com", RequestID: "req-1"}
+
+	renderLog(nil, DefaultRequestLogTemplate, data, Options{Logger: logger})
+
+	if len(logger.lines) != 1 {
+		t.Fatalf("got %d log lines, want 1", len(logger.lines))
+	}
+	if !strings.Contains(logger.lines[0], "req-1") {
+		t.Errorf("log line %q missing request ID", logger.lines[0])
+	}
+	if !strings.Contains(logger.lines[0], "GET") {
+		t.Errorf("log line %q missing method", logger.lines[0])
+	}
+}
+
+func TestRenderLogJSON(t *testing.T) {
+	logger := &captureLogger{}
+	data := LogData{Method: "POST", URL: "http://example.com", RequestID: "req-2"}
+
+	renderLog(nil, DefaultRequestLogTemplate, data, Options{Logger: logger, JSONLog: true})
+
+	if len(logger.lines) != 1 {
+		t.Fatalf("got %d log lines, want 1", len(logger.lines))
+	}
+	line := logger.lines[0]
+	if !strings.HasPrefix(line, "{") {
+		t.Errorf("JSONLog line %q does not look like JSON", line)
+	}
+	if !strings.Contains(line, `"RequestID":"req-2"`) {
+		t.Errorf("JSONLog line %q missing RequestID field", line)
+	}
+}
+
+func TestLogRequestNoopWhenDebugDisabled(t *testing.T) {
+	logger := &captureLogger{}
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	logRequest(req, Options{Logger: logger, Debug: false})
+
+	if len(logger.lines) != 0 {
+		t.Fatalf("expected no log lines with Debug=false, got %v", logger.lines)
+	}
+}
@@ -0,0 +1,162 @@
+package requests
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RoundTripFunc performs a single HTTP round trip, the same shape as
+// *http.Client.Do. It is the unit that middlewares wrap.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc with additional behavior such as
+// logging, auth, or retries-adjacent bookkeeping.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// chainMiddlewares composes middlewares around a base RoundTripFunc.
+// Middlewares run in the order they are provided: the first middleware
+// sees the outgoing request first and the incoming response last.
+func chainMiddlewares(base RoundTripFunc, middlewares []Middleware) RoundTripFunc {
+	chained := base
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		chained = middlewares[i](chained)
+	}
+	return chained
+}
+
+// ------------------------
+// Request ID
+// ------------------------
+
+type contextKey string
+
+const requestIDContextKey contextKey = "requestID"
+
+// WithRequestID returns a context carrying the given request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, id)
+}
+
+// RequestIDFromContext returns the request ID stored by RequestIDMiddleware,
+// or "" if none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey).(string)
+	return id
+}
+
+// newRequestID returns a random UUID (RFC 4122 version 4) used to
+// correlate a request with its logs across retries.
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// RequestIDMiddleware attaches an X-Request-ID header to the outgoing
+// request, reusing whichever ID is already present (doRequest stamps one
+// onto the context once per logical request, before retries begin, via
+// WithRequestID) so every retry attempt of the same request shares the
+// same ID. Falls back to the existing header, then to minting a fresh
+// UUID, for callers that invoke it outside of doRequest's retry loop.
+func RequestIDMiddleware(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		id := RequestIDFromContext(req.Context())
+		if id == "" {
+			id = req.Header.Get(HEADER_X_REQUEST_ID)
+		}
+		if id == "" {
+			id = newRequestID()
+		}
+		req.Header.Set(HEADER_X_REQUEST_ID, id)
+		req = req.WithContext(WithRequestID(req.Context(), id))
+		return next(req)
+	}
+}
+
+// ------------------------
+// Gzip decoding
+// ------------------------
+
+// GzipMiddleware asks the server for a gzip-encoded response and
+// transparently decodes it before it reaches handleResponse.
+func GzipMiddleware(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get(HEADER_ACCEPT_ENCODING) == "" {
+			req.Header.Set(HEADER_ACCEPT_ENCODING, "gzip")
+		}
+
+		resp, err := next(req)
+		if err != nil || resp == nil {
+			return resp, err
+		}
+
+		if resp.Header.Get(HEADER_CONTENT_ENCODING) == "gzip" {
+			gz, gzErr := gzip.NewReader(resp.Body)
+			if gzErr != nil {
+				return resp, gzErr
+			}
+			resp.Body = &gzipReadCloser{gz: gz, src: resp.Body}
+			resp.Header.Del(HEADER_CONTENT_ENCODING)
+		}
+
+		return resp, nil
+	}
+}
+
+// gzipReadCloser closes both the gzip reader and the underlying response
+// body it wraps, so callers can Close() it like any other response body.
+type gzipReadCloser struct {
+	gz  *gzip.Reader
+	src io.ReadCloser
+}
+
+func (g *gzipReadCloser) Read(p []byte) (int, error) { return g.gz.Read(p) }
+func (g *gzipReadCloser) Close() error {
+	g.gz.Close()
+	return g.src.Close()
+}
+
+// ------------------------
+// Bearer token refresh
+// ------------------------
+
+// BearerTokenMiddleware attaches a bearer token to every request,
+// calling tokenFunc on each attempt so callers can refresh an expired
+// token transparently (e.g. from an OAuth client-credentials flow).
+func BearerTokenMiddleware(tokenFunc func() (string, error)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			token, err := tokenFunc()
+			if err != nil {
+				return nil, fmt.Errorf("bearer token: %w", err)
+			}
+			req.Header.Set(HEADER_AUTHORIZATION, "Bearer "+token)
+			return next(req)
+		}
+	}
+}
+
+// ------------------------
+// Metrics timing
+// ------------------------
+
+// MetricsMiddleware times each round trip and reports it via record,
+// letting callers wire request latency into their own metrics backend
+// without this package depending on one.
+func MetricsMiddleware(record func(req *http.Request, resp *http.Response, duration time.Duration, err error)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			record(req, resp, time.Since(start), err)
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestChainMiddlewaresOrder(t *testing.T) {
+	var order []string
+
+	record := func(label string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, "before:"+label)
+				resp, err := next(req)
+				order = append(order, "after:"+label)
+				return resp, err
+			}
+		}
+	}
+
+	base := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		order = append(order, "base")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	chained := chainMiddlewares(base, []Middleware{record("first"), record("second")})
+	if _, err := chained(&http.Request{}); err != nil {
+		t.Fatalf("chained request returned error: %v", err)
+	}
+
+	want := []string{"before:first", "before:second", "base", "after:second", "after:first"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestChainMiddlewaresNoMiddlewaresIsBase(t *testing.T) {
+	called := false
+	base := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return nil, nil
+	})
+
+	chained := chainMiddlewares(base, nil)
+	if _, err := chained(&http.Request{}); err != nil {
+		t.Fatalf("chained request returned error: %v", err)
+	}
+	if !called {
+		t.Fatal("base round-tripper was not invoked")
+	}
+}
+
+func TestRequestIDMiddlewareReusesContextID(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	req = req.WithContext(WithRequestID(req.Context(), "fixed-id"))
+
+	var seenID string
+	base := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		seenID = req.Header.Get(HEADER_X_REQUEST_ID)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	if _, err := RequestIDMiddleware(base)(req); err != nil {
+		t.Fatalf("RequestIDMiddleware returned error: %v", err)
+	}
+	if seenID != "fixed-id" {
+		t.Fatalf("X-Request-ID = %q, want %q", seenID, "fixed-id")
+	}
+}
+
+func TestRequestIDMiddlewareMintsIDWhenAbsent(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	var seenID string
+	base := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		seenID = req.Header.Get(HEADER_X_REQUEST_ID)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	if _, err := RequestIDMiddleware(base)(req); err != nil {
+		t.Fatalf("RequestIDMiddleware returned error: %v", err)
+	}
+	if seenID == "" {
+		t.Fatal("RequestIDMiddleware left X-Request-ID empty")
+	}
+}
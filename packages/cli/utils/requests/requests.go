@@ -7,10 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
-	"net"
 	"net/http"
 	"net/url"
+	"sync/atomic"
+	"text/template"
 	"time"
 )
 
@@ -38,6 +38,8 @@ const (
 	HEADER_CONTENT_ENCODING = "Content-Encoding" // Encoding such as gzip/deflate
 	HEADER_ACCEPT_ENCODING  = "Accept-Encoding"  // What encodings the client can accept
 	HEADER_ACCEPT_LANGUAGE  = "Accept-Language"  // Preferred language for the response
+	HEADER_X_REQUEST_ID     = "X-Request-ID"     // Correlates a request with its logs across retries
+	HEADER_RETRY_AFTER      = "Retry-After"      // Seconds or HTTP-date the client should wait before retrying
 )
 
 // ------------------------
@@ -60,19 +62,59 @@ const (
 	LOG_RESPONSE_BODY   = "Response Body"
 )
 
-// Default HTTP client with timeout.
-// Users can replace this by overriding directly if needed.
-var client = &http.Client{Timeout: 15 * time.Second}
+// clientPtr holds the shared package-level HTTP client behind an atomic
+// pointer: Configure can be called concurrently with in-flight
+// Get/Post/etc. calls (e.g. from a long-running watch/FetchAll caller),
+// so swapping it out needs to be safe without a lock on the hot path.
+var clientPtr = func() *atomic.Pointer[http.Client] {
+	p := &atomic.Pointer[http.Client]{}
+	p.Store(&http.Client{Timeout: 15 * time.Second})
+	return p
+}()
+
+// currentClient returns the shared HTTP client in effect right now.
+func currentClient() *http.Client {
+	return clientPtr.Load()
+}
 
 // Options configures request behavior: headers, query params,
 // body payload, timeout per request, and retry behavior.
 type Options struct {
-	Header  http.Header
-	Query   map[string]string
-	Body    interface{}
-	Timeout time.Duration
-	Retries int
-	Debug   bool
+	Header      http.Header
+	Query       map[string]string
+	Body        interface{}
+	Timeout     time.Duration
+	Retries     int
+	Debug       bool
+	Middlewares []Middleware
+	// Context is the parent context for every attempt; each attempt
+	// derives a child context.WithTimeout from it, so canceling Context
+	// (e.g. on Ctrl-C) aborts an in-flight attempt instead of merely
+	// skipping the ones that haven't started. Defaults to
+	// context.Background() when nil.
+	Context context.Context
+	// RetryPolicy controls backoff timing and which non-5xx statuses are
+	// retried; zero-valued fields fall back to sane defaults (see
+	// resolveRetryPolicy).
+	RetryPolicy RetryPolicy
+	// Transport overrides the shared client's transport for this
+	// request only; use Configure to change it package-wide instead.
+	Transport *http.Transport
+
+	// Logger receives rendered request/response log lines; defaults to
+	// the standard log package when nil.
+	Logger Logger
+	// RequestLogTemplate and ResponseLogTemplate render the pre-flight
+	// request log and post-flight response log respectively; each
+	// defaults to DefaultRequestLogTemplate / DefaultResponseLogTemplate.
+	RequestLogTemplate  *template.Template
+	ResponseLogTemplate *template.Template
+	// RedactHeaders lists header names (case-insensitive) whose values
+	// are replaced with "[REDACTED]" before logs are rendered.
+	RedactHeaders []string
+	// JSONLog renders LogData as JSON instead of through the templates,
+	// for machine-readable log consumption.
+	JSONLog bool
 }
 
 // ------------------------
@@ -132,31 +174,6 @@ func createRequest(ctx context.Context, method string, u *url.URL, body io.Reade
 	return req, nil
 }
 
-// shouldRetry determines whether a request should be retried.
-// Retries happen on:
-// - network errors
-// - HTTP 5xx server errors
-// - only if attempts < maxRetries
-func shouldRetry(err error, status int, attempt, maxRetries int) bool {
-	if attempt >= maxRetries {
-		return false
-	}
-
-	// Retry network errors (connection reset, timeout, etc.)
-	if err != nil {
-		var netErr net.Error
-		return errors.As(err, &netErr)
-	}
-
-	// Retry 5xx errors
-	return status >= 500 && status <= 599
-}
-
-// backoff waits before trying the next retry attempt.
-func backoff(attempt int) {
-	time.Sleep(time.Duration(attempt+1) * 300 * time.Millisecond)
-}
-
 // ------------------------
 // Main request functions
 // ------------------------
@@ -178,30 +195,41 @@ func handleResponse(resp *http.Response) ([]byte, int, error) {
 }
 
 // attemptRequest builds the URL, encodes the body, creates the request,
-// and executes it using the shared HTTP client.
+// and executes it through the configured middleware chain on top of the
+// shared HTTP client. It also returns the (possibly middleware-mutated)
+// request so callers can log details such as the request ID.
 func attemptRequest(
 	method string,
 	rawURL string,
 	options Options,
 	ctx context.Context,
-) (*http.Response, error) {
+) (*http.Response, *http.Request, error) {
 
 	urlObj, err := buildURL(rawURL, options.Query)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	body, err := buildBody(options.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req, err := createRequest(ctx, method, urlObj, body, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	logRequest(req, options)
+
+	httpClient := currentClient()
+	if options.Transport != nil {
+		httpClient = &http.Client{Timeout: httpClient.Timeout, Transport: options.Transport}
 	}
 
-	return client.Do(req)
+	do := chainMiddlewares(RoundTripFunc(httpClient.Do), options.Middlewares)
+	resp, err := do(req)
+	return resp, req, err
 }
 
 // joinHeaderValues joins multiple header values into a single string
@@ -217,40 +245,6 @@ func joinHeaderValues(values []string) string {
 	}()
 }
 
-// logResponse prints request/response debug info with colors
-func logResponse(resp *http.Response, body []byte, options Options) {
-	if !options.Debug || resp == nil {
-		return
-	}
-
-	// Limit body output
-	const maxBodyLength = 1000
-	bodyStr := string(body)
-	if len(bodyStr) > maxBodyLength {
-		bodyStr = bodyStr[:maxBodyLength] + "...[truncated]"
-	}
-
-	// Format headers
-	var headersBuf bytes.Buffer
-	for k, v := range resp.Header {
-		headersBuf.WriteString(ColorCyan + k + ColorReset + ": " + joinHeaderValues(v) + "; ")
-	}
-
-	// Color status based on code
-	statusColor := ColorGreen
-	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-		statusColor = ColorYellow
-	} else if resp.StatusCode >= 500 {
-		statusColor = ColorRed
-	}
-
-	log.Println(ColorBlue + "===== HTTP Response Debug =====" + ColorReset)
-	log.Printf("Status: %s%s%s\n", statusColor, resp.Status, ColorReset)
-	log.Printf("Headers: %s\n", headersBuf.String())
-	log.Printf("Body: %s%s%s\n", ColorGray, bodyStr, ColorReset)
-	log.Println(ColorBlue + "===============================" + ColorReset)
-}
-
 // doRequest performs the full request cycle including:
 // - timeout control
 // - retry logic
@@ -258,6 +252,7 @@ func logResponse(resp *http.Response, body []byte, options Options) {
 // - logging (status + body)
 func doRequest(method, rawURL string, options Options) ([]byte, error) {
 	maxRetries := options.Retries
+	policy := resolveRetryPolicy(options.RetryPolicy)
 
 	// Default timeout if not provided
 	timeout := options.Timeout
@@ -265,22 +260,37 @@ func doRequest(method, rawURL string, options Options) ([]byte, error) {
 		timeout = 10 * time.Second
 	}
 
+	parentCtx := options.Context
+	if parentCtx == nil {
+		parentCtx = context.Background()
+	}
+
+	// Stamp a single request ID for this logical request, before any
+	// attempt runs, so every retry's child context (derived from
+	// parentCtx below) carries the same ID for RequestIDMiddleware and
+	// the logger to pick up.
+	if RequestIDFromContext(parentCtx) == "" {
+		parentCtx = WithRequestID(parentCtx, newRequestID())
+	}
+
 	var lastErr error
 
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 
-		// Each attempt gets its own context with timeout
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		// Each attempt gets its own context with timeout, derived from
+		// the caller's context so cancellation aborts it mid-flight.
+		ctx, cancel := context.WithTimeout(parentCtx, timeout)
 
-		resp, err := attemptRequest(method, rawURL, options, ctx)
+		start := time.Now()
+		resp, req, err := attemptRequest(method, rawURL, options, ctx)
 		cancel()
 
 		// Network error or connection failure
 		if err != nil {
 			lastErr = err
 
-			if shouldRetry(err, 0, attempt, maxRetries) {
-				backoff(attempt)
+			if shouldRetry(err, 0, attempt, maxRetries, policy) {
+				backoff(parentCtx, attempt, policy, 0)
 				continue
 			}
 			return nil, err
@@ -292,15 +302,15 @@ func doRequest(method, rawURL string, options Options) ([]byte, error) {
 			return nil, readErr
 		}
 
-		// Retry server failures (HTTP 5xx)
-		if shouldRetry(nil, status, attempt, maxRetries) {
+		// Retry server failures (HTTP 5xx) and any status in policy.RetryOn
+		if shouldRetry(nil, status, attempt, maxRetries, policy) {
 			lastErr = fmt.Errorf("server error: %v", resp.Status)
-			backoff(attempt)
+			backoff(parentCtx, attempt, policy, parseRetryAfter(resp.Header.Get(HEADER_RETRY_AFTER)))
 			continue
 		}
 
 		// Debug logging
-		logResponse(resp, body, options)
+		logResponseData(req, resp, body, time.Since(start), options)
 
 		return body, nil
 	}
@@ -0,0 +1,84 @@
+package requests
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClientOptions configures the shared package-level HTTP client's
+// transport: TLS trust/identity, proxying, connection pooling, and dial
+// timing. Use Configure to apply it once at startup; for a one-off
+// override on a single request, set Options.Transport instead.
+type ClientOptions struct {
+	// RootCAs, if set, replaces the system cert pool used to verify
+	// server certificates (e.g. for a corporate MITM proxy's CA).
+	RootCAs *x509.CertPool
+	// Certificates are presented to servers that require mTLS.
+	Certificates []tls.Certificate
+	// InsecureSkipVerify disables server certificate verification; only
+	// use this against trusted test/internal endpoints.
+	InsecureSkipVerify bool
+	// ProxyURL routes all requests through an HTTP or SOCKS proxy.
+	ProxyURL *url.URL
+	// MaxConnsPerHost and MaxIdleConnsPerHost bound the shared
+	// transport's per-host connection pool.
+	MaxConnsPerHost     int
+	MaxIdleConnsPerHost int
+	// DialTimeout bounds establishing the TCP connection, independent of
+	// Timeout which bounds the whole request/response cycle.
+	DialTimeout time.Duration
+	// Timeout bounds the overall request/response cycle, mirroring
+	// http.Client.Timeout. Defaults to the package's existing 15s.
+	Timeout time.Duration
+}
+
+// buildTransport constructs an *http.Transport reflecting opts, starting
+// from a clone of http.DefaultTransport for any field opts leaves unset.
+func buildTransport(opts ClientOptions) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:            opts.RootCAs,
+		Certificates:       opts.Certificates,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	if opts.ProxyURL != nil {
+		proxyURL := opts.ProxyURL
+		transport.Proxy = func(*http.Request) (*url.URL, error) { return proxyURL, nil }
+	}
+
+	if opts.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = opts.MaxConnsPerHost
+	}
+	if opts.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+
+	dialTimeout := opts.DialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = 30 * time.Second
+	}
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	transport.DialContext = dialer.DialContext
+
+	return transport
+}
+
+// Configure rebuilds the shared package-level HTTP client from opts and
+// atomically swaps it in, so it's safe to call while other goroutines
+// have requests in flight (e.g. a long-running watch or FetchAll
+// caller); those in-flight requests keep using whichever client they
+// already loaded. It replaces the client used by every call that
+// doesn't set a per-request Options.Transport.
+func Configure(opts ClientOptions) {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 15 * time.Second
+	}
+	clientPtr.Store(&http.Client{Timeout: timeout, Transport: buildTransport(opts)})
+}
@@ -0,0 +1,80 @@
+package requests
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// TestConfigureTrustsCustomCAPool verifies that Configure's RootCAs option
+// actually drives certificate verification: a client configured with a
+// pool containing only the test server's self-signed cert should trust
+// it, where the default transport would reject it.
+func TestConfigureTrustsCustomCAPool(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	Configure(ClientOptions{RootCAs: pool})
+	defer Configure(ClientOptions{})
+
+	body, err := Get(server.URL, Options{})
+	if err != nil {
+		t.Fatalf("Get with custom CA pool failed: %v", err)
+	}
+	if string(body) != "ok" {
+		t.Fatalf("got body %q, want %q", body, "ok")
+	}
+}
+
+// TestConfigureRejectsUntrustedServer is the control case: without the
+// server's cert in the trusted pool, the request should fail verification.
+func TestConfigureRejectsUntrustedServer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	Configure(ClientOptions{RootCAs: x509.NewCertPool()})
+	defer Configure(ClientOptions{})
+
+	if _, err := Get(server.URL, Options{}); err == nil {
+		t.Fatal("expected Get to fail against an untrusted self-signed server, got nil error")
+	}
+}
+
+// TestConfigureConcurrentWithInFlightRequests exercises Configure racing
+// against concurrent Get calls (run it with -race): the shared client
+// is swapped via an atomic pointer, so this should be race-free even
+// though FetchAll now makes requests.Get concurrent by default.
+func TestConfigureConcurrentWithInFlightRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer Configure(ClientOptions{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = Get(server.URL, Options{})
+		}()
+	}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			Configure(ClientOptions{MaxConnsPerHost: n + 1})
+		}(i)
+	}
+	wg.Wait()
+}